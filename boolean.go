@@ -70,3 +70,27 @@ func (v *Boolean) UnmarshalEasyJSON(l *jlexer.Lexer) {
 		v.Value = l.Bool()
 	}
 }
+
+// MarshalJSON implements encoding/json.Marshaler, so Boolean can be used in
+// structs that are marshaled with the stdlib encoding/json package.
+func (v Boolean) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	v.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler, so Boolean can be used
+// in structs that are unmarshaled with the stdlib encoding/json package.
+func (v *Boolean) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	v.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// IsZero reports whether v is the zero value, i.e. the field was absent.
+//
+// It is used by encoding/json to determine whether the field should be
+// marshaled when using the Go 1.24 `omitzero` tag.
+func (v Boolean) IsZero() bool {
+	return !v.Present
+}
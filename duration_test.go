@@ -0,0 +1,67 @@
+package jsontypes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func TestDurationPresentValue(t *testing.T) {
+	var d Duration
+	d.UnmarshalEasyJSON(&jlexer.Lexer{Data: []byte(`"1h30m"`)})
+
+	if !d.Present || !d.Valid {
+		t.Fatalf("Present = %v, Valid = %v, want true, true", d.Present, d.Valid)
+	}
+	want := 90 * time.Minute
+	if d.Value != want {
+		t.Errorf("Value = %v, want %v", d.Value, want)
+	}
+	if got := d.Get(0); got != want {
+		t.Errorf("Get(0) = %v, want %v", got, want)
+	}
+}
+
+func TestDurationNull(t *testing.T) {
+	var d Duration
+	d.UnmarshalEasyJSON(&jlexer.Lexer{Data: []byte("null")})
+
+	if !d.Present {
+		t.Error("Present = false after unmarshaling null, want true")
+	}
+	if d.Valid {
+		t.Error("Valid = true after unmarshaling null, want false")
+	}
+	if got := d.Get(time.Second); got != time.Second {
+		t.Errorf("Get(fallback) = %v, want %v", got, time.Second)
+	}
+
+	w := jwriter.Writer{}
+	d.MarshalEasyJSON(&w)
+	out, err := w.BuildBytes()
+	if err != nil {
+		t.Fatalf("BuildBytes() error = %v", err)
+	}
+	if string(out) != "null" {
+		t.Errorf("MarshalEasyJSON(null Duration) = %s, want null", out)
+	}
+}
+
+func TestDurationAbsent(t *testing.T) {
+	var d Duration
+	if d.IsDefined() {
+		t.Error("IsDefined() = true for a zero-value Duration, want false")
+	}
+	if !d.IsZero() {
+		t.Error("IsZero() = false for a zero-value Duration, want true")
+	}
+}
+
+func TestDurationInvalid(t *testing.T) {
+	var d Duration
+	if err := d.UnmarshalJSON([]byte(`"not-a-duration"`)); err == nil {
+		t.Error("UnmarshalJSON with an invalid duration returned nil error, want an error")
+	}
+}
@@ -2,7 +2,11 @@ package jsontypes
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
 	"strconv"
+	"sync/atomic"
 
 	"github.com/mailru/easyjson/jlexer"
 	"github.com/mailru/easyjson/jwriter"
@@ -24,6 +28,8 @@ const (
 	kindUInt64
 	kindFloat32
 	kindFloat64
+	kindBigInt
+	kindBigFloat
 )
 
 // Number represents an optional JSON field of type number.
@@ -50,6 +56,8 @@ type Number struct {
 	signed   int64
 	unsigned uint64
 	float    float64
+	bigInt   *big.Int
+	bigFloat *big.Float
 }
 
 // IsDefined reports whether the field was present in the input JSON,
@@ -64,10 +72,42 @@ func (v *Number) IsDefined() bool {
 // Get returns the contained value if the field is present and non-null.
 // Otherwise, it returns the supplied fallback value.
 func (v *Number) Get(value json.Number) json.Number {
-	if v.Present && v.Valid && len(v.Value) > 0 {
-		return v.Value
-	} else {
-		return value
+	if v.Present && v.Valid {
+		v.backfillValue()
+		if len(v.Value) > 0 {
+			return v.Value
+		}
+	}
+	return value
+}
+
+// backfillValue lazily materializes Value (the source json.Number text) from
+// whichever kind is currently cached. It is needed because SetXxx and the
+// eager UnmarshalXxxEasyJSON fast paths resolve a concrete numeric value
+// without ever populating Value, so a later call to a *different* accessor
+// (or to Get) would otherwise silently parse an empty string. It is a no-op
+// if Value is already populated or nothing has been resolved yet.
+func (v *Number) backfillValue() {
+	if len(v.Value) > 0 {
+		return
+	}
+	switch v.kind {
+	case kindInt, kindInt8, kindInt16, kindInt32, kindInt64:
+		v.Value = json.Number(strconv.FormatInt(v.signed, 10))
+	case kindUInt, kindUInt8, kindUInt16, kindUInt32, kindUInt64:
+		v.Value = json.Number(strconv.FormatUint(v.unsigned, 10))
+	case kindFloat32:
+		v.Value = json.Number(strconv.FormatFloat(v.float, 'f', -1, 32))
+	case kindFloat64:
+		v.Value = json.Number(strconv.FormatFloat(v.float, 'f', -1, 64))
+	case kindBigInt:
+		if v.bigInt != nil {
+			v.Value = json.Number(v.bigInt.String())
+		}
+	case kindBigFloat:
+		if v.bigFloat != nil {
+			v.Value = json.Number(v.bigFloat.Text('f', -1))
+		}
 	}
 }
 
@@ -109,6 +149,10 @@ func (v *Number) MarshalEasyJSON(w *jwriter.Writer) {
 			w.Float32(float32(v.float))
 		case kindFloat64:
 			w.Float64(v.float)
+		case kindBigInt:
+			w.RawString(v.bigInt.String())
+		case kindBigFloat:
+			w.RawString(v.bigFloat.Text('f', -1))
 		default:
 			panic("cannot marshal unknown number kind")
 		}
@@ -128,9 +172,84 @@ func (v *Number) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	}
 }
 
+// MarshalJSON implements encoding/json.Marshaler, so Number can be used in
+// structs that are marshaled with the stdlib encoding/json package.
+func (v Number) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	v.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler, so Number can be used
+// in structs that are unmarshaled with the stdlib encoding/json package.
+func (v *Number) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	v.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// IsZero reports whether v is the zero value, i.e. the field was absent.
+//
+// It is used by encoding/json to determine whether the field should be
+// marshaled when using the Go 1.24 `omitzero` tag.
+func (v *Number) IsZero() bool {
+	return !v.Present
+}
+
+// UnmarshalInt64EasyJSON is a fast-path alternative to UnmarshalEasyJSON for
+// callers that know ahead of time that the field holds a signed integer,
+// e.g. generated code that has a static type hint for the field. It reads
+// the value directly via l.Int64, skipping the json.Number allocation that
+// UnmarshalEasyJSON performs, and leaves the value ready for Int64() to
+// return without reparsing.
+//
+// Trade-off: Value is left empty, so this method should not be used for
+// fields that are re-marshaled through the kindString path or otherwise need
+// the original decimal text preserved.
+func (v *Number) UnmarshalInt64EasyJSON(l *jlexer.Lexer) {
+	v.Present = true
+	if l.IsNull() {
+		l.Skip()
+	} else {
+		v.Valid = true
+		v.signed = l.Int64()
+		v.kind = kindInt64
+		v.Value = ""
+	}
+}
+
+// UnmarshalUint64EasyJSON is the unsigned counterpart of
+// UnmarshalInt64EasyJSON; see its documentation for the trade-off.
+func (v *Number) UnmarshalUint64EasyJSON(l *jlexer.Lexer) {
+	v.Present = true
+	if l.IsNull() {
+		l.Skip()
+	} else {
+		v.Valid = true
+		v.unsigned = l.Uint64()
+		v.kind = kindUInt64
+		v.Value = ""
+	}
+}
+
+// UnmarshalFloat64EasyJSON is the floating-point counterpart of
+// UnmarshalInt64EasyJSON; see its documentation for the trade-off.
+func (v *Number) UnmarshalFloat64EasyJSON(l *jlexer.Lexer) {
+	v.Present = true
+	if l.IsNull() {
+		l.Skip()
+	} else {
+		v.Valid = true
+		v.float = l.Float64()
+		v.kind = kindFloat64
+		v.Value = ""
+	}
+}
+
 // ParseInt parses the underlying number value to an int.
 func (v *Number) ParseInt() (int, error) {
 	if v.kind != kindInt {
+		v.backfillValue()
 		var err error
 		if v.signed, err = strconv.ParseInt(string(v.Value), 10, strconv.IntSize); err == nil {
 			v.kind = kindInt
@@ -154,6 +273,7 @@ func (v *Number) Int() int {
 // ParseInt8 parses the underlying number value to an int8.
 func (v *Number) ParseInt8() (int8, error) {
 	if v.kind != kindInt8 {
+		v.backfillValue()
 		var err error
 		if v.signed, err = strconv.ParseInt(string(v.Value), 10, 8); err == nil {
 			v.kind = kindInt8
@@ -177,6 +297,7 @@ func (v *Number) Int8() int8 {
 // ParseInt16 parses the underlying number value to an int16.
 func (v *Number) ParseInt16() (int16, error) {
 	if v.kind != kindInt16 {
+		v.backfillValue()
 		var err error
 		if v.signed, err = strconv.ParseInt(string(v.Value), 10, 16); err == nil {
 			v.kind = kindInt16
@@ -200,6 +321,7 @@ func (v *Number) Int16() int16 {
 // ParseInt32 parses the underlying number value to an int32.
 func (v *Number) ParseInt32() (int32, error) {
 	if v.kind != kindInt32 {
+		v.backfillValue()
 		var err error
 		if v.signed, err = strconv.ParseInt(string(v.Value), 10, 32); err == nil {
 			v.kind = kindInt32
@@ -223,6 +345,7 @@ func (v *Number) Int32() int32 {
 // ParseInt64 parses the underlying number value to an int64.
 func (v *Number) ParseInt64() (int64, error) {
 	if v.kind != kindInt64 {
+		v.backfillValue()
 		var err error
 		if v.signed, err = strconv.ParseInt(string(v.Value), 10, 64); err == nil {
 			v.kind = kindInt64
@@ -246,6 +369,7 @@ func (v *Number) Int64() int64 {
 // ParseUInt parses the underlying number value to an uint.
 func (v *Number) ParseUInt() (uint, error) {
 	if v.kind != kindUInt {
+		v.backfillValue()
 		var err error
 		if v.unsigned, err = strconv.ParseUint(string(v.Value), 10, strconv.IntSize); err == nil {
 			v.kind = kindUInt
@@ -269,6 +393,7 @@ func (v *Number) UInt() uint {
 // ParseUInt8 parses the underlying number value to an uint8.
 func (v *Number) ParseUInt8() (uint8, error) {
 	if v.kind != kindUInt8 {
+		v.backfillValue()
 		var err error
 		if v.unsigned, err = strconv.ParseUint(string(v.Value), 10, 8); err == nil {
 			v.kind = kindUInt8
@@ -292,6 +417,7 @@ func (v *Number) UInt8() uint8 {
 // ParseUInt16 parses the underlying number value to an uint16.
 func (v *Number) ParseUInt16() (uint16, error) {
 	if v.kind != kindUInt16 {
+		v.backfillValue()
 		var err error
 		if v.unsigned, err = strconv.ParseUint(string(v.Value), 10, 16); err == nil {
 			v.kind = kindUInt16
@@ -315,6 +441,7 @@ func (v *Number) UInt16() uint16 {
 // ParseUInt32 parses the underlying number value to an uint32.
 func (v *Number) ParseUInt32() (uint32, error) {
 	if v.kind != kindUInt32 {
+		v.backfillValue()
 		var err error
 		if v.unsigned, err = strconv.ParseUint(string(v.Value), 10, 32); err == nil {
 			v.kind = kindUInt32
@@ -338,6 +465,7 @@ func (v *Number) UInt32() uint32 {
 // ParseUInt64 parses the underlying number value to an uint64.
 func (v *Number) ParseUInt64() (uint64, error) {
 	if v.kind != kindUInt64 {
+		v.backfillValue()
 		var err error
 		if v.unsigned, err = strconv.ParseUint(string(v.Value), 10, 64); err == nil {
 			v.kind = kindUInt64
@@ -361,6 +489,7 @@ func (v *Number) UInt64() uint64 {
 // ParseFloat32 parses the underlying number value to a float32.
 func (v *Number) ParseFloat32() (float32, error) {
 	if v.kind != kindFloat32 {
+		v.backfillValue()
 		var err error
 		if v.float, err = strconv.ParseFloat(string(v.Value), 32); err == nil {
 			v.kind = kindFloat32
@@ -384,6 +513,7 @@ func (v *Number) Float32() float32 {
 // ParseFloat64 parses the underlying number value to a float64.
 func (v *Number) ParseFloat64() (float64, error) {
 	if v.kind != kindFloat64 {
+		v.backfillValue()
 		var err error
 		if v.float, err = strconv.ParseFloat(string(v.Value), 64); err == nil {
 			v.kind = kindFloat64
@@ -408,70 +538,198 @@ func (v *Number) Float64() float64 {
 func (v *Number) SetInt(value int) {
 	v.kind = kindInt
 	v.signed = int64(value)
+	v.Value = ""
 }
 
 // SetInt8 assigns an int8 as the underlying number value.
 func (v *Number) SetInt8(value int8) {
 	v.kind = kindInt8
 	v.signed = int64(value)
+	v.Value = ""
 }
 
 // SetInt16 assigns an int16 as the underlying number value.
 func (v *Number) SetInt16(value int16) {
 	v.kind = kindInt16
 	v.signed = int64(value)
+	v.Value = ""
 }
 
 // SetInt32 assigns an int32 as the underlying number value.
 func (v *Number) SetInt32(value int32) {
 	v.kind = kindInt32
 	v.signed = int64(value)
+	v.Value = ""
 }
 
 // SetInt64 assigns an int64 as the underlying number value.
 func (v *Number) SetInt64(value int64) {
 	v.kind = kindInt64
 	v.signed = value
+	v.Value = ""
 }
 
 // SetUInt assigns an uint as the underlying number value.
 func (v *Number) SetUInt(value uint) {
 	v.kind = kindUInt
 	v.unsigned = uint64(value)
+	v.Value = ""
 }
 
 // SetUInt8 assigns an uint8 as the underlying number value.
 func (v *Number) SetUInt8(value uint8) {
 	v.kind = kindUInt8
 	v.unsigned = uint64(value)
+	v.Value = ""
 }
 
 // SetUInt16 assigns an uint16 as the underlying number value.
 func (v *Number) SetUInt16(value uint16) {
 	v.kind = kindUInt16
 	v.unsigned = uint64(value)
+	v.Value = ""
 }
 
 // SetUInt32 assigns an uint32 as the underlying number value.
 func (v *Number) SetUInt32(value uint32) {
 	v.kind = kindUInt32
 	v.unsigned = uint64(value)
+	v.Value = ""
 }
 
 // SetUInt64 assigns an uint64 as the underlying number value.
 func (v *Number) SetUInt64(value uint64) {
 	v.kind = kindUInt64
 	v.unsigned = value
+	v.Value = ""
 }
 
 // SetFloat32 assigns a float32 as the underlying number value.
 func (v *Number) SetFloat32(value float32) {
 	v.kind = kindFloat32
 	v.float = float64(value)
+	v.Value = ""
 }
 
 // SetFloat64 assigns a float64 as the underlying number value.
 func (v *Number) SetFloat64(value float64) {
 	v.kind = kindFloat64
 	v.float = value
+	v.Value = ""
+}
+
+// ParseBigInt parses the underlying number value to a *big.Int.
+//
+// Use this instead of Int64/UInt64 when the value may exceed the range of a
+// fixed-width integer, e.g. large IDs or values from a foreign system.
+func (v *Number) ParseBigInt() (*big.Int, error) {
+	if v.kind != kindBigInt {
+		v.backfillValue()
+		value, ok := new(big.Int).SetString(string(v.Value), 10)
+		if !ok {
+			return nil, fmt.Errorf("jsontypes: cannot parse %q as big.Int", v.Value)
+		}
+		v.bigInt = value
+		v.kind = kindBigInt
+	}
+	return v.bigInt, nil
+}
+
+// BigInt returns the number as a *big.Int.
+// Returns nil in case of parse error.
+func (v *Number) BigInt() *big.Int {
+	if v.kind != kindBigInt {
+		value, _ := v.ParseBigInt()
+		return value
+	}
+	return v.bigInt
+}
+
+// SetBigInt assigns a *big.Int as the underlying number value.
+//
+// It panics if value is nil, since a nil *big.Int paired with kindBigInt
+// would otherwise panic later, and less clearly, inside MarshalEasyJSON.
+func (v *Number) SetBigInt(value *big.Int) {
+	if value == nil {
+		panic("jsontypes: SetBigInt requires a non-nil *big.Int")
+	}
+	v.kind = kindBigInt
+	v.bigInt = value
+	v.Value = ""
+}
+
+// ParseBigFloat parses the underlying number value to a *big.Float.
+//
+// Use this instead of Float32/Float64 when the value carries more precision
+// than a float64 mantissa can hold, e.g. monetary amounts or scientific data.
+func (v *Number) ParseBigFloat() (*big.Float, error) {
+	if v.kind != kindBigFloat {
+		v.backfillValue()
+		// Four mantissa bits per input character keep every decimal digit;
+		// big.Float's default of 64 bits would round longer values.
+		value, ok := new(big.Float).SetPrec(uint(4 * len(v.Value))).SetString(string(v.Value))
+		if !ok {
+			return nil, fmt.Errorf("jsontypes: cannot parse %q as big.Float", v.Value)
+		}
+		v.bigFloat = value
+		v.kind = kindBigFloat
+	}
+	return v.bigFloat, nil
+}
+
+// BigFloat returns the number as a *big.Float.
+// Returns nil in case of parse error.
+func (v *Number) BigFloat() *big.Float {
+	if v.kind != kindBigFloat {
+		value, _ := v.ParseBigFloat()
+		return value
+	}
+	return v.bigFloat
+}
+
+// SetBigFloat assigns a *big.Float as the underlying number value.
+//
+// It panics if value is nil, since a nil *big.Float paired with kindBigFloat
+// would otherwise panic later, and less clearly, inside MarshalEasyJSON.
+func (v *Number) SetBigFloat(value *big.Float) {
+	if value == nil {
+		panic("jsontypes: SetBigFloat requires a non-nil *big.Float")
+	}
+	v.kind = kindBigFloat
+	v.bigFloat = value
+	v.Value = ""
+}
+
+// DecimalParser converts the raw decimal string representation of a Number
+// into a caller-supplied decimal type, such as shopspring/decimal.Decimal.
+//
+// It is deliberately an indirection rather than a direct dependency so that
+// this package does not force a decimal library choice on its users.
+type DecimalParser func(s string) (any, error)
+
+// decimalParser is the parser used by ParseDecimal, if any has been
+// registered via SetDecimalParser. It is stored in an atomic.Pointer since
+// SetDecimalParser may run concurrently with ParseDecimal calls elsewhere in
+// the process.
+var decimalParser atomic.Pointer[DecimalParser]
+
+// SetDecimalParser registers the parser used by ParseDecimal.
+//
+// Call this once at program startup, e.g. with a thin wrapper around
+// shopspring/decimal.NewFromString, before any Number.ParseDecimal calls.
+func SetDecimalParser(parser DecimalParser) {
+	decimalParser.Store(&parser)
+}
+
+// ParseDecimal parses the underlying number value using the parser
+// registered with SetDecimalParser.
+//
+// It returns an error if no parser has been registered.
+func (v *Number) ParseDecimal() (any, error) {
+	parser := decimalParser.Load()
+	if parser == nil {
+		return nil, errors.New("jsontypes: no decimal parser registered, call SetDecimalParser")
+	}
+	v.backfillValue()
+	return (*parser)(string(v.Value))
 }
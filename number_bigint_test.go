@@ -0,0 +1,72 @@
+package jsontypes
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestNumberBigIntRoundTrip(t *testing.T) {
+	var n Number
+	n.Present, n.Valid = true, true
+	n.SetBigInt(big.NewInt(12345678901234567))
+
+	got, err := n.ParseBigInt()
+	if err != nil {
+		t.Fatalf("ParseBigInt() error = %v", err)
+	}
+	if got.String() != "12345678901234567" {
+		t.Errorf("ParseBigInt() = %s, want 12345678901234567", got)
+	}
+}
+
+func TestNumberBigFloatRoundTrip(t *testing.T) {
+	var n Number
+	n.Present, n.Valid = true, true
+	n.SetBigFloat(big.NewFloat(3.5))
+
+	got, err := n.ParseBigFloat()
+	if err != nil {
+		t.Fatalf("ParseBigFloat() error = %v", err)
+	}
+	if f, _ := got.Float64(); f != 3.5 {
+		t.Errorf("ParseBigFloat() = %v, want 3.5", f)
+	}
+}
+
+func TestNumberSetBigIntNilPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("SetBigInt(nil) did not panic")
+		}
+	}()
+	var n Number
+	n.SetBigInt(nil)
+}
+
+func TestNumberSetBigFloatNilPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("SetBigFloat(nil) did not panic")
+		}
+	}()
+	var n Number
+	n.SetBigFloat(nil)
+}
+
+func TestNumberParseDecimal(t *testing.T) {
+	SetDecimalParser(func(s string) (any, error) {
+		return s, nil
+	})
+	defer decimalParser.Store(nil)
+
+	var n Number
+	n.Set("12345678901234567890.12345")
+
+	got, err := n.ParseDecimal()
+	if err != nil {
+		t.Fatalf("ParseDecimal() error = %v", err)
+	}
+	if got != "12345678901234567890.12345" {
+		t.Errorf("ParseDecimal() = %v, want 12345678901234567890.12345", got)
+	}
+}
@@ -0,0 +1,123 @@
+package jsontypes
+
+import (
+	"testing"
+
+	"github.com/mailru/easyjson/jlexer"
+)
+
+// TestNumberFastPathAccessorMismatch verifies that reading a Number through
+// an accessor other than the one matching an eager UnmarshalXxxEasyJSON call
+// still returns the correct value instead of silently falling through to an
+// empty Value string.
+func TestNumberFastPathAccessorMismatch(t *testing.T) {
+	var n Number
+	n.UnmarshalInt64EasyJSON(&jlexer.Lexer{Data: []byte("42")})
+
+	if got := n.Int(); got != 42 {
+		t.Errorf("Int() = %d, want 42", got)
+	}
+	if got := n.UInt64(); got != 42 {
+		t.Errorf("UInt64() = %d, want 42", got)
+	}
+	if got := n.Float64(); got != 42 {
+		t.Errorf("Float64() = %v, want 42", got)
+	}
+	if got := n.Get("fallback"); got != "42" {
+		t.Errorf(`Get("fallback") = %q, want "42"`, got)
+	}
+	if got, err := n.ParseBigInt(); err != nil || got.Int64() != 42 {
+		t.Errorf("ParseBigInt() = (%v, %v), want (42, nil)", got, err)
+	}
+}
+
+func TestNumberFastPathUnmarshalVariants(t *testing.T) {
+	var f Number
+	f.UnmarshalFloat64EasyJSON(&jlexer.Lexer{Data: []byte("3.5")})
+	if got := f.Float64(); got != 3.5 {
+		t.Errorf("Float64() = %v, want 3.5", got)
+	}
+	if got := f.Get("fallback"); got != "3.5" {
+		t.Errorf(`Get("fallback") = %q, want "3.5"`, got)
+	}
+
+	var u Number
+	u.UnmarshalUint64EasyJSON(&jlexer.Lexer{Data: []byte("7")})
+	if got := u.Int64(); got != 7 {
+		t.Errorf("Int64() = %d, want 7", got)
+	}
+}
+
+// TestNumberStaleValueAfterReassignment verifies that a numeric Set/eager
+// unmarshal invalidates any Value string cached from a previous
+// representation of the same Number, so a later mismatched-kind accessor
+// can't read stale text.
+func TestNumberStaleValueAfterReassignment(t *testing.T) {
+	var n Number
+	n.Set("999")
+	n.UnmarshalInt64EasyJSON(&jlexer.Lexer{Data: []byte("42")})
+
+	if got := n.Int64(); got != 42 {
+		t.Errorf("Int64() = %d, want 42", got)
+	}
+	if got := n.Get("fallback"); got != "42" {
+		t.Errorf(`Get("fallback") = %q, want "42"`, got)
+	}
+	if got := n.Int(); got != 42 {
+		t.Errorf("Int() = %d, want 42", got)
+	}
+
+	var m Number
+	m.Set("999")
+	m.SetInt64(42)
+	if got := m.Int(); got != 42 {
+		t.Errorf("Int() = %d, want 42", got)
+	}
+	if got := m.Get("fallback"); got != "42" {
+		t.Errorf(`Get("fallback") = %q, want "42"`, got)
+	}
+}
+
+// TestNumberSetFloat32Precision verifies that backfillValue formats a
+// kindFloat32 value at float32 precision, matching what MarshalEasyJSON
+// emits, instead of widening it through float64 formatting.
+func TestNumberSetFloat32Precision(t *testing.T) {
+	SetDecimalParser(func(s string) (any, error) {
+		return s, nil
+	})
+	defer decimalParser.Store(nil)
+
+	var n Number
+	n.SetFloat32(1.1)
+	n.Present, n.Valid = true, true
+
+	if got := n.Get("fallback"); got != "1.1" {
+		t.Errorf(`Get("fallback") = %q, want "1.1"`, got)
+	}
+
+	got, err := n.ParseDecimal()
+	if err != nil {
+		t.Fatalf("ParseDecimal() error = %v", err)
+	}
+	if got != "1.1" {
+		t.Errorf("ParseDecimal() = %v, want %q", got, "1.1")
+	}
+}
+
+func BenchmarkNumberUnmarshalEasyJSON(b *testing.B) {
+	data := []byte("1234567890")
+	for i := 0; i < b.N; i++ {
+		var n Number
+		n.UnmarshalEasyJSON(&jlexer.Lexer{Data: data})
+		_ = n.Int64()
+	}
+}
+
+func BenchmarkNumberUnmarshalInt64EasyJSON(b *testing.B) {
+	data := []byte("1234567890")
+	for i := 0; i < b.N; i++ {
+		var n Number
+		n.UnmarshalInt64EasyJSON(&jlexer.Lexer{Data: data})
+		_ = n.Int64()
+	}
+}
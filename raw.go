@@ -0,0 +1,103 @@
+package jsontypes
+
+import (
+	"encoding/json"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// Raw represents an optional JSON field whose value is captured verbatim,
+// without being parsed, similar to encoding/json.RawMessage but with the
+// tri-state Present/Valid semantics of this package.
+//
+// It models three distinct states:
+//   - field not present in the JSON:     Present = false, Valid = false
+//   - field present with null value:     Present = true,  Valid = false
+//   - field present with a real value:   Present = true,  Valid = true
+//
+// This is useful for proxy/relay use cases where an intermediate service
+// needs to preserve unknown fields exactly, including number precision,
+// while still tracking whether the field was absent, null, or supplied.
+type Raw struct {
+	// Present is true if the JSON field exists, even if the value is null.
+	Present bool
+
+	// Valid is true only when the field is present and the value is non-null.
+	Valid bool
+
+	// Value holds the raw, unparsed JSON bytes when both Present and Valid
+	// are true.
+	Value []byte
+}
+
+// IsDefined reports whether the field was present in the input JSON,
+// regardless of whether it contained null or a non-null value.
+//
+// It is used by easyjson to determine whether the field should be marshaled
+// when using the `omitempty` tag.
+func (v Raw) IsDefined() bool {
+	return v.Present
+}
+
+// Get returns the contained raw bytes.
+func (v Raw) Get() []byte {
+	return v.Value
+}
+
+// Set assigns raw bytes and marks the field as present and non-null.
+func (v *Raw) Set(value []byte) {
+	v.Present = true
+	v.Valid = true
+	v.Value = value
+}
+
+// Decode parses the captured raw bytes into dst, using encoding/json.
+// It returns an error if the field was not present or was null.
+func (v Raw) Decode(dst any) error {
+	return json.Unmarshal(v.Value, dst)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler.
+func (v Raw) MarshalEasyJSON(w *jwriter.Writer) {
+	if v.Valid {
+		w.Raw(v.Value, nil)
+	} else {
+		w.RawString("null")
+	}
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler.
+func (v *Raw) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	v.Present = true
+	if l.IsNull() {
+		l.Skip()
+	} else {
+		v.Valid = true
+		v.Value = append([]byte(nil), l.Raw()...)
+	}
+}
+
+// MarshalJSON implements encoding/json.Marshaler, so Raw can be used in
+// structs that are marshaled with the stdlib encoding/json package.
+func (v Raw) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	v.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler, so Raw can be used in
+// structs that are unmarshaled with the stdlib encoding/json package.
+func (v *Raw) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	v.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// IsZero reports whether v is the zero value, i.e. the field was absent.
+//
+// It is used by encoding/json to determine whether the field should be
+// marshaled when using the Go 1.24 `omitzero` tag.
+func (v Raw) IsZero() bool {
+	return !v.Present
+}
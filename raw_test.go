@@ -0,0 +1,107 @@
+package jsontypes
+
+import (
+	"testing"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func TestRawPreservesHighPrecisionNumber(t *testing.T) {
+	const src = `12345678901234567890.123456789`
+	var r Raw
+	r.UnmarshalEasyJSON(&jlexer.Lexer{Data: []byte(src)})
+
+	if !r.Present || !r.Valid {
+		t.Fatalf("Present = %v, Valid = %v, want true, true", r.Present, r.Valid)
+	}
+	if string(r.Value) != src {
+		t.Errorf("Value = %s, want %s", r.Value, src)
+	}
+
+	w := jwriter.Writer{}
+	r.MarshalEasyJSON(&w)
+	out, err := w.BuildBytes()
+	if err != nil {
+		t.Fatalf("BuildBytes() error = %v", err)
+	}
+	if string(out) != src {
+		t.Errorf("MarshalEasyJSON round trip = %s, want %s", out, src)
+	}
+}
+
+func TestRawPreservesNestedPayload(t *testing.T) {
+	const src = `{"a":[1,2,{"b":"c"}],"d":null}`
+	var r Raw
+	r.UnmarshalEasyJSON(&jlexer.Lexer{Data: []byte(src)})
+
+	if string(r.Value) != src {
+		t.Errorf("Value = %s, want %s", r.Value, src)
+	}
+
+	w := jwriter.Writer{}
+	r.MarshalEasyJSON(&w)
+	out, err := w.BuildBytes()
+	if err != nil {
+		t.Fatalf("BuildBytes() error = %v", err)
+	}
+	if string(out) != src {
+		t.Errorf("MarshalEasyJSON round trip = %s, want %s", out, src)
+	}
+}
+
+func TestRawAbsent(t *testing.T) {
+	var r Raw
+	if r.IsDefined() {
+		t.Error("IsDefined() = true for a zero-value Raw, want false")
+	}
+	if !r.IsZero() {
+		t.Error("IsZero() = false for a zero-value Raw, want true")
+	}
+}
+
+func TestRawNull(t *testing.T) {
+	var r Raw
+	r.UnmarshalEasyJSON(&jlexer.Lexer{Data: []byte("null")})
+
+	if !r.Present {
+		t.Error("Present = false after unmarshaling null, want true")
+	}
+	if r.Valid {
+		t.Error("Valid = true after unmarshaling null, want false")
+	}
+
+	w := jwriter.Writer{}
+	r.MarshalEasyJSON(&w)
+	out, err := w.BuildBytes()
+	if err != nil {
+		t.Fatalf("BuildBytes() error = %v", err)
+	}
+	if string(out) != "null" {
+		t.Errorf("MarshalEasyJSON(null Raw) = %s, want null", out)
+	}
+}
+
+func TestRawDecode(t *testing.T) {
+	var r Raw
+	r.Set([]byte(`{"a":1,"b":"c"}`))
+
+	var dst struct {
+		A int    `json:"a"`
+		B string `json:"b"`
+	}
+	if err := r.Decode(&dst); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if dst.A != 1 || dst.B != "c" {
+		t.Errorf("Decode() = %+v, want {A:1 B:c}", dst)
+	}
+}
+
+func TestRawDecodeAbsent(t *testing.T) {
+	var r Raw
+	var dst any
+	if err := r.Decode(&dst); err == nil {
+		t.Error("Decode() on an absent Raw returned nil error, want an error")
+	}
+}
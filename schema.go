@@ -0,0 +1,56 @@
+package jsontypes
+
+// Schema is a minimal JSON Schema document, suitable for embedding in a
+// larger schema produced by tools such as kin-openapi or invopop/jsonschema.
+type Schema map[string]any
+
+// JSONSchema returns the JSON Schema for Number: a nullable number.
+func (v Number) JSONSchema() Schema {
+	return Schema{"type": []string{"number", "null"}}
+}
+
+// JSONSchema returns the JSON Schema for Boolean: a nullable boolean.
+func (v Boolean) JSONSchema() Schema {
+	return Schema{"type": []string{"boolean", "null"}}
+}
+
+// JSONSchema returns the JSON Schema for Null: always null.
+func (v Null) JSONSchema() Schema {
+	return Schema{"type": "null"}
+}
+
+// JSONSchema returns the JSON Schema for String: a nullable string.
+func (v String) JSONSchema() Schema {
+	return Schema{"type": []string{"string", "null"}}
+}
+
+// JSONSchema returns the JSON Schema for Time: a nullable RFC3339 timestamp.
+// It reports "format": "date-time" even when SetTimeLayout has set another
+// layout.
+func (v Time) JSONSchema() Schema {
+	return Schema{
+		"type":   []string{"string", "null"},
+		"format": "date-time",
+	}
+}
+
+// JSONSchema returns the JSON Schema for Duration: a nullable Go duration
+// string, e.g. "1h30m".
+//
+// It does not use the JSON Schema "format": "duration" keyword, since that
+// is reserved for ISO-8601 durations (e.g. "P1DT2H"), not the
+// time.Duration.String() format this package actually produces.
+func (v Duration) JSONSchema() Schema {
+	return Schema{
+		"type":          []string{"string", "null"},
+		"x-go-duration": true,
+	}
+}
+
+// JSONSchema returns the JSON Schema for UUID: a nullable UUID string.
+func (v UUID) JSONSchema() Schema {
+	return Schema{
+		"type":   []string{"string", "null"},
+		"format": "uuid",
+	}
+}
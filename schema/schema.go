@@ -0,0 +1,36 @@
+// Package schema derives JSON Schema fragments for jsontypes optional
+// fields, so that struct reflectors such as kin-openapi or
+// invopop/jsonschema describe them as nullable scalars instead of as
+// structs with Present/Valid/Value fields.
+package schema
+
+import (
+	"reflect"
+
+	"github.com/binadel/jsontypes"
+)
+
+// schemaer is implemented by every jsontypes optional type except Raw via
+// its JSONSchema method.
+type schemaer interface {
+	JSONSchema() jsontypes.Schema
+}
+
+// Reflect returns the JSON Schema fragment for t if t (or *t) is one of the
+// jsontypes optional types, and reports whether one was found. Raw has no
+// JSONSchema method, so Reflect reports not found for it.
+//
+// Wire this into a reflector's custom-mapping hook, e.g.
+// invopop/jsonschema's Reflector.Mapper or kin-openapi's
+// SchemaCustomizer, to get correct `nullable: true` output for struct
+// fields typed as jsontypes.Number, jsontypes.Boolean, and so on.
+func Reflect(t reflect.Type) (jsontypes.Schema, bool) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	zero := reflect.Zero(t).Interface()
+	if s, ok := zero.(schemaer); ok {
+		return s.JSONSchema(), true
+	}
+	return nil, false
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/binadel/jsontypes"
+)
+
+func TestReflect(t *testing.T) {
+	cases := []struct {
+		name string
+		typ  reflect.Type
+		want jsontypes.Schema
+	}{
+		{"Number", reflect.TypeOf(jsontypes.Number{}), jsontypes.Schema{"type": []string{"number", "null"}}},
+		{"Boolean", reflect.TypeOf(jsontypes.Boolean{}), jsontypes.Schema{"type": []string{"boolean", "null"}}},
+		{"Null", reflect.TypeOf(jsontypes.Null{}), jsontypes.Schema{"type": "null"}},
+		{"String", reflect.TypeOf(jsontypes.String{}), jsontypes.Schema{"type": []string{"string", "null"}}},
+		{"Time", reflect.TypeOf(jsontypes.Time{}), jsontypes.Schema{"type": []string{"string", "null"}, "format": "date-time"}},
+		{"Duration", reflect.TypeOf(jsontypes.Duration{}), jsontypes.Schema{"type": []string{"string", "null"}, "x-go-duration": true}},
+		{"UUID", reflect.TypeOf(jsontypes.UUID{}), jsontypes.Schema{"type": []string{"string", "null"}, "format": "uuid"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := Reflect(c.typ)
+			if !ok {
+				t.Fatalf("Reflect(%s) reported not found", c.name)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("Reflect(%s) = %#v, want %#v", c.name, got, c.want)
+			}
+		})
+	}
+}
+
+func TestReflectUnknownType(t *testing.T) {
+	if _, ok := Reflect(reflect.TypeOf(0)); ok {
+		t.Error("Reflect(int) reported found, want not found")
+	}
+}
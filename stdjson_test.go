@@ -0,0 +1,70 @@
+package jsontypes
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// isZeroer is implemented by every optional type in this package via its
+// IsZero method, matching the Go 1.24 encoding/json `omitzero` contract.
+type isZeroer interface {
+	IsZero() bool
+}
+
+func TestOptionalTypesStdlibJSON(t *testing.T) {
+	var b Boolean
+	b.Set(true)
+	testStdlibMarshal(t, &b, "true")
+
+	var n Number
+	n.SetInt64(42)
+	n.Present, n.Valid = true, true
+	testStdlibMarshal(t, &n, "42")
+
+	var s String
+	s.Set("hi")
+	testStdlibMarshal(t, &s, `"hi"`)
+
+	tm := Time{}
+	tm.Set(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	testStdlibMarshal(t, &tm, `"2024-01-02T03:04:05Z"`)
+
+	d := Duration{}
+	d.Set(90 * time.Second)
+	testStdlibMarshal(t, &d, `"1m30s"`)
+
+	u := UUID{}
+	u.Set([16]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0})
+	testStdlibMarshal(t, &u, `"12345678-9abc-def0-1234-56789abcdef0"`)
+
+	var nl Null
+	nl.Present = true
+	testStdlibMarshal(t, &nl, "null")
+
+	var r Raw
+	r.Set([]byte(`{"a":1}`))
+	testStdlibMarshal(t, &r, `{"a":1}`)
+}
+
+func testStdlibMarshal(t *testing.T, v any, want string) {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("json.Marshal(%T) error = %v", v, err)
+	}
+	if string(data) != want {
+		t.Errorf("json.Marshal(%T) = %s, want %s", v, data, want)
+	}
+}
+
+func TestOptionalTypesIsZero(t *testing.T) {
+	cases := []isZeroer{
+		&Boolean{}, &Number{}, &String{}, &Time{}, &Duration{}, &UUID{}, &Null{}, &Raw{},
+	}
+	for _, v := range cases {
+		if !v.IsZero() {
+			t.Errorf("%T{}.IsZero() = false, want true for an absent field", v)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package jsontypes
+
+import (
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// String represents an optional JSON field of type string.
+//
+// It models three distinct states:
+//   - field not present in the JSON:     Present = false, Valid = false
+//   - field present with null value:     Present = true,  Valid = false
+//   - field present with a real value:   Present = true,  Valid = true
+//
+// This is useful when you need to know whether a field existed in the input,
+// not just whether its value is null.
+type String struct {
+	// Present is true if the JSON field exists, even if the value is null.
+	Present bool
+
+	// Valid is true only when the field is present and the value is non-null.
+	Valid bool
+
+	// Value holds the underlying string when both Present and Valid are true.
+	Value string
+}
+
+// IsDefined reports whether the field was present in the input JSON,
+// regardless of whether it contained null or a non-null value.
+//
+// It is used by easyjson to determine whether the field should be marshaled
+// when using the `omitempty` tag.
+func (v String) IsDefined() bool {
+	return v.Present
+}
+
+// Get returns the contained value if the field is present and non-null.
+// Otherwise, it returns the supplied fallback value.
+func (v String) Get(value string) string {
+	if v.Present && v.Valid {
+		return v.Value
+	} else {
+		return value
+	}
+}
+
+// Set assigns a non-null value and marks the field as present.
+func (v *String) Set(value string) {
+	v.Present = true
+	v.Valid = true
+	v.Value = value
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler.
+func (v String) MarshalEasyJSON(w *jwriter.Writer) {
+	if v.Valid {
+		w.String(v.Value)
+	} else {
+		w.RawString("null")
+	}
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler.
+func (v *String) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	v.Present = true
+	if l.IsNull() {
+		l.Skip()
+	} else {
+		v.Valid = true
+		v.Value = l.String()
+	}
+}
+
+// MarshalJSON implements encoding/json.Marshaler, so String can be used in
+// structs that are marshaled with the stdlib encoding/json package.
+func (v String) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	v.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler, so String can be used
+// in structs that are unmarshaled with the stdlib encoding/json package.
+func (v *String) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	v.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// IsZero reports whether v is the zero value, i.e. the field was absent.
+//
+// It is used by encoding/json to determine whether the field should be
+// marshaled when using the Go 1.24 `omitzero` tag.
+func (v String) IsZero() bool {
+	return !v.Present
+}
@@ -0,0 +1,61 @@
+package jsontypes
+
+import (
+	"testing"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func TestStringPresentValue(t *testing.T) {
+	var s String
+	s.UnmarshalEasyJSON(&jlexer.Lexer{Data: []byte(`"hello"`)})
+
+	if !s.Present || !s.Valid {
+		t.Fatalf("Present = %v, Valid = %v, want true, true", s.Present, s.Valid)
+	}
+	if s.Value != "hello" {
+		t.Errorf("Value = %q, want %q", s.Value, "hello")
+	}
+	if got := s.Get("fallback"); got != "hello" {
+		t.Errorf(`Get("fallback") = %q, want "hello"`, got)
+	}
+}
+
+func TestStringNull(t *testing.T) {
+	var s String
+	s.UnmarshalEasyJSON(&jlexer.Lexer{Data: []byte("null")})
+
+	if !s.Present {
+		t.Error("Present = false after unmarshaling null, want true")
+	}
+	if s.Valid {
+		t.Error("Valid = true after unmarshaling null, want false")
+	}
+	if got := s.Get("fallback"); got != "fallback" {
+		t.Errorf(`Get("fallback") = %q, want "fallback"`, got)
+	}
+
+	w := jwriter.Writer{}
+	s.MarshalEasyJSON(&w)
+	out, err := w.BuildBytes()
+	if err != nil {
+		t.Fatalf("BuildBytes() error = %v", err)
+	}
+	if string(out) != "null" {
+		t.Errorf("MarshalEasyJSON(null String) = %s, want null", out)
+	}
+}
+
+func TestStringAbsent(t *testing.T) {
+	var s String
+	if s.IsDefined() {
+		t.Error("IsDefined() = true for a zero-value String, want false")
+	}
+	if got := s.Get("fallback"); got != "fallback" {
+		t.Errorf(`Get("fallback") = %q, want "fallback"`, got)
+	}
+	if !s.IsZero() {
+		t.Error("IsZero() = false for a zero-value String, want true")
+	}
+}
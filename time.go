@@ -0,0 +1,125 @@
+package jsontypes
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// timeLayout is the layout used to marshal and unmarshal Time values. It is
+// stored in an atomic.Pointer since SetTimeLayout may run concurrently with
+// Time marshaling/unmarshaling elsewhere in the process.
+var timeLayout atomic.Pointer[string]
+
+func init() {
+	layout := time.RFC3339
+	timeLayout.Store(&layout)
+}
+
+// SetTimeLayout overrides the layout used to marshal and unmarshal Time
+// values.
+//
+// It defaults to time.RFC3339; call SetTimeLayout once at program startup,
+// e.g. with time.RFC3339Nano, before any Time marshal/unmarshal calls.
+func SetTimeLayout(layout string) {
+	timeLayout.Store(&layout)
+}
+
+// Time represents an optional JSON field of type string containing a
+// timestamp in the layout configured via SetTimeLayout (time.RFC3339 by
+// default).
+//
+// It models three distinct states:
+//   - field not present in the JSON:     Present = false, Valid = false
+//   - field present with null value:     Present = true,  Valid = false
+//   - field present with a real value:   Present = true,  Valid = true
+//
+// This is useful when you need to know whether a field existed in the input,
+// not just whether its value is null.
+type Time struct {
+	// Present is true if the JSON field exists, even if the value is null.
+	Present bool
+
+	// Valid is true only when the field is present and the value is non-null.
+	Valid bool
+
+	// Value holds the underlying time when both Present and Valid are true.
+	Value time.Time
+}
+
+// IsDefined reports whether the field was present in the input JSON,
+// regardless of whether it contained null or a non-null value.
+//
+// It is used by easyjson to determine whether the field should be marshaled
+// when using the `omitempty` tag.
+func (v Time) IsDefined() bool {
+	return v.Present
+}
+
+// Get returns the contained value if the field is present and non-null.
+// Otherwise, it returns the supplied fallback value.
+func (v Time) Get(value time.Time) time.Time {
+	if v.Present && v.Valid {
+		return v.Value
+	} else {
+		return value
+	}
+}
+
+// Set assigns a non-null value and marks the field as present.
+func (v *Time) Set(value time.Time) {
+	v.Present = true
+	v.Valid = true
+	v.Value = value
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler.
+func (v Time) MarshalEasyJSON(w *jwriter.Writer) {
+	if v.Valid {
+		w.String(v.Value.Format(*timeLayout.Load()))
+	} else {
+		w.RawString("null")
+	}
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler.
+func (v *Time) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	v.Present = true
+	if l.IsNull() {
+		l.Skip()
+	} else {
+		value, err := time.Parse(*timeLayout.Load(), l.String())
+		if err != nil {
+			l.AddError(err)
+			return
+		}
+		v.Valid = true
+		v.Value = value
+	}
+}
+
+// MarshalJSON implements encoding/json.Marshaler, so Time can be used in
+// structs that are marshaled with the stdlib encoding/json package.
+func (v Time) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	v.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler, so Time can be used in
+// structs that are unmarshaled with the stdlib encoding/json package.
+func (v *Time) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	v.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// IsZero reports whether v is the zero value, i.e. the field was absent.
+//
+// It is used by encoding/json to determine whether the field should be
+// marshaled when using the Go 1.24 `omitzero` tag.
+func (v Time) IsZero() bool {
+	return !v.Present
+}
@@ -0,0 +1,68 @@
+package jsontypes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func TestTimePresentValue(t *testing.T) {
+	var tm Time
+	tm.UnmarshalEasyJSON(&jlexer.Lexer{Data: []byte(`"2024-01-02T03:04:05Z"`)})
+
+	if !tm.Present || !tm.Valid {
+		t.Fatalf("Present = %v, Valid = %v, want true, true", tm.Present, tm.Valid)
+	}
+	want := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	if !tm.Value.Equal(want) {
+		t.Errorf("Value = %v, want %v", tm.Value, want)
+	}
+	if got := tm.Get(time.Time{}); !got.Equal(want) {
+		t.Errorf("Get() = %v, want %v", got, want)
+	}
+}
+
+func TestTimeNull(t *testing.T) {
+	var tm Time
+	tm.UnmarshalEasyJSON(&jlexer.Lexer{Data: []byte("null")})
+
+	if !tm.Present {
+		t.Error("Present = false after unmarshaling null, want true")
+	}
+	if tm.Valid {
+		t.Error("Valid = true after unmarshaling null, want false")
+	}
+	fallback := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got := tm.Get(fallback); !got.Equal(fallback) {
+		t.Errorf("Get(fallback) = %v, want %v", got, fallback)
+	}
+
+	w := jwriter.Writer{}
+	tm.MarshalEasyJSON(&w)
+	out, err := w.BuildBytes()
+	if err != nil {
+		t.Fatalf("BuildBytes() error = %v", err)
+	}
+	if string(out) != "null" {
+		t.Errorf("MarshalEasyJSON(null Time) = %s, want null", out)
+	}
+}
+
+func TestTimeAbsent(t *testing.T) {
+	var tm Time
+	if tm.IsDefined() {
+		t.Error("IsDefined() = true for a zero-value Time, want false")
+	}
+	if !tm.IsZero() {
+		t.Error("IsZero() = false for a zero-value Time, want true")
+	}
+}
+
+func TestTimeInvalid(t *testing.T) {
+	var tm Time
+	if err := tm.UnmarshalJSON([]byte(`"not-a-time"`)); err == nil {
+		t.Error("UnmarshalJSON with an invalid time returned nil error, want an error")
+	}
+}
@@ -0,0 +1,140 @@
+package jsontypes
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// UUID represents an optional JSON field of type string containing a UUID
+// in canonical 8-4-4-4-12 hyphenated form.
+//
+// It models three distinct states:
+//   - field not present in the JSON:     Present = false, Valid = false
+//   - field present with null value:     Present = true,  Valid = false
+//   - field present with a real value:   Present = true,  Valid = true
+//
+// This is useful when you need to know whether a field existed in the input,
+// not just whether its value is null.
+type UUID struct {
+	// Present is true if the JSON field exists, even if the value is null.
+	Present bool
+
+	// Valid is true only when the field is present and the value is non-null.
+	Valid bool
+
+	// Value holds the underlying UUID bytes when both Present and Valid are true.
+	Value [16]byte
+}
+
+// IsDefined reports whether the field was present in the input JSON,
+// regardless of whether it contained null or a non-null value.
+//
+// It is used by easyjson to determine whether the field should be marshaled
+// when using the `omitempty` tag.
+func (v UUID) IsDefined() bool {
+	return v.Present
+}
+
+// Get returns the contained value if the field is present and non-null.
+// Otherwise, it returns the supplied fallback value.
+func (v UUID) Get(value [16]byte) [16]byte {
+	if v.Present && v.Valid {
+		return v.Value
+	} else {
+		return value
+	}
+}
+
+// Set assigns a non-null value and marks the field as present.
+func (v *UUID) Set(value [16]byte) {
+	v.Present = true
+	v.Valid = true
+	v.Value = value
+}
+
+// String returns the canonical 8-4-4-4-12 hyphenated representation.
+func (v UUID) String() string {
+	return formatUUID(v.Value)
+}
+
+// MarshalEasyJSON implements easyjson.Marshaler.
+func (v UUID) MarshalEasyJSON(w *jwriter.Writer) {
+	if v.Valid {
+		w.String(formatUUID(v.Value))
+	} else {
+		w.RawString("null")
+	}
+}
+
+// UnmarshalEasyJSON implements easyjson.Unmarshaler.
+func (v *UUID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	v.Present = true
+	if l.IsNull() {
+		l.Skip()
+	} else {
+		value, err := parseUUID(l.String())
+		if err != nil {
+			l.AddError(err)
+			return
+		}
+		v.Valid = true
+		v.Value = value
+	}
+}
+
+// MarshalJSON implements encoding/json.Marshaler, so UUID can be used in
+// structs that are marshaled with the stdlib encoding/json package.
+func (v UUID) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	v.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// UnmarshalJSON implements encoding/json.Unmarshaler, so UUID can be used in
+// structs that are unmarshaled with the stdlib encoding/json package.
+func (v *UUID) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	v.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// IsZero reports whether v is the zero value, i.e. the field was absent.
+//
+// It is used by encoding/json to determine whether the field should be
+// marshaled when using the Go 1.24 `omitzero` tag.
+func (v UUID) IsZero() bool {
+	return !v.Present
+}
+
+// parseUUID parses a canonical 8-4-4-4-12 hyphenated UUID string.
+func parseUUID(s string) ([16]byte, error) {
+	var u [16]byte
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, fmt.Errorf("jsontypes: invalid UUID %q", s)
+	}
+	hexDigits := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:36]
+	decoded, err := hex.DecodeString(hexDigits)
+	if err != nil {
+		return u, fmt.Errorf("jsontypes: invalid UUID %q", s)
+	}
+	copy(u[:], decoded)
+	return u, nil
+}
+
+// formatUUID renders a UUID in canonical 8-4-4-4-12 hyphenated form.
+func formatUUID(u [16]byte) string {
+	buf := make([]byte, 36)
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:36], u[10:16])
+	return string(buf)
+}
@@ -0,0 +1,75 @@
+package jsontypes
+
+import (
+	"testing"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func TestUUIDPresentValue(t *testing.T) {
+	const src = "12345678-9abc-def0-1234-56789abcdef0"
+	var u UUID
+	u.UnmarshalEasyJSON(&jlexer.Lexer{Data: []byte(`"` + src + `"`)})
+
+	if !u.Present || !u.Valid {
+		t.Fatalf("Present = %v, Valid = %v, want true, true", u.Present, u.Valid)
+	}
+	if u.String() != src {
+		t.Errorf("String() = %s, want %s", u.String(), src)
+	}
+	if got := u.Get([16]byte{}); got != u.Value {
+		t.Errorf("Get(zero) = %v, want %v", got, u.Value)
+	}
+}
+
+func TestUUIDNull(t *testing.T) {
+	var u UUID
+	u.UnmarshalEasyJSON(&jlexer.Lexer{Data: []byte("null")})
+
+	if !u.Present {
+		t.Error("Present = false after unmarshaling null, want true")
+	}
+	if u.Valid {
+		t.Error("Valid = true after unmarshaling null, want false")
+	}
+	fallback := [16]byte{1}
+	if got := u.Get(fallback); got != fallback {
+		t.Errorf("Get(fallback) = %v, want %v", got, fallback)
+	}
+
+	w := jwriter.Writer{}
+	u.MarshalEasyJSON(&w)
+	out, err := w.BuildBytes()
+	if err != nil {
+		t.Fatalf("BuildBytes() error = %v", err)
+	}
+	if string(out) != "null" {
+		t.Errorf("MarshalEasyJSON(null UUID) = %s, want null", out)
+	}
+}
+
+func TestUUIDAbsent(t *testing.T) {
+	var u UUID
+	if u.IsDefined() {
+		t.Error("IsDefined() = true for a zero-value UUID, want false")
+	}
+	if !u.IsZero() {
+		t.Error("IsZero() = false for a zero-value UUID, want true")
+	}
+}
+
+func TestUUIDInvalid(t *testing.T) {
+	cases := []string{
+		"too-short",
+		"12345678-9abc-def0-1234-56789abcdef0x", // too long
+		"123456789abc-def0-1234-56789abcdef0",   // missing hyphen
+		"zzzzzzzz-9abc-def0-1234-56789abcdef0",  // invalid hex
+	}
+	for _, c := range cases {
+		var u UUID
+		if err := u.UnmarshalJSON([]byte(`"` + c + `"`)); err == nil {
+			t.Errorf("UnmarshalJSON(%q) returned nil error, want an error", c)
+		}
+	}
+}